@@ -5,9 +5,23 @@ import (
 	"errors"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/ini.v1"
+	"gopkg.in/yaml.v3"
 )
 
 type (
+	// Format identifies the encoding used by a settings file.
+	Format string
+
+	// Decoder is an interface to decode raw settings data into a settings struct.
+	Decoder interface {
+		Decode(data []byte, settings any) error
+	}
+
 	// EnvUpdater is an interface to update settings from other sources, for example environment variables.
 	EnvUpdater interface {
 		Update(settings any) error
@@ -21,9 +35,34 @@ type (
 	// Composer is a struct to read application settings from multiple sources and validate them if needed.
 	Composer struct {
 		jsonReader io.ReadCloser
+		decoder    Decoder
 		updater    EnvUpdater
 		validator  Validator
+		sources    []ConfigSource
+
+		filePath    string
+		listenersMu sync.Mutex
+		listeners   map[string]func()
+
+		envSubstitution bool
 	}
+
+	// JSONDecoder decodes JSON formatted settings.
+	JSONDecoder struct{}
+
+	// YAMLDecoder decodes YAML formatted settings.
+	YAMLDecoder struct{}
+
+	// INIDecoder decodes INI formatted settings.
+	// Note: gopkg.in/ini.v1 maps sections to struct fields one level deep, so settings
+	// structs with deeper nesting should prefer JSON or YAML.
+	INIDecoder struct{}
+)
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatINI  Format = "ini"
 )
 
 var (
@@ -38,10 +77,62 @@ var (
 
 	ErrUpdateSettings   = errors.New("failed to update settings with env vars")
 	ErrValidateSettings = errors.New("failed to validate settings")
+	ErrLoadSettings     = errors.New("failed to load settings from source")
+
+	ErrSettingsNotPointer   = errors.New("settings parameter must be a pointer")
+	ErrSourceMapUnsupported = errors.New("ReadWithSources does not support a Composer configured with WithSources, use Read instead")
+
+	ErrWatchUnsupported = errors.New("composer has no file path to watch, use NewComposerWithFile or NewComposerWithReader(nil)")
+	ErrWatcherSetup     = errors.New("failed to set up file watcher")
+
+	ErrEnvSubstitution = errors.New("failed to substitute environment variable")
 
 	DefaultAppSettingsFile = "appsettings.json"
 )
 
+// Decode unmarshals JSON formatted data into settings.
+func (JSONDecoder) Decode(data []byte, settings any) error {
+	return json.Unmarshal(data, settings)
+}
+
+// Decode unmarshals YAML formatted data into settings.
+func (YAMLDecoder) Decode(data []byte, settings any) error {
+	return yaml.Unmarshal(data, settings)
+}
+
+// Decode unmarshals INI formatted data into settings.
+func (INIDecoder) Decode(data []byte, settings any) error {
+	f, err := ini.Load(data)
+	if err != nil {
+		return err
+	}
+	return f.MapTo(settings)
+}
+
+// decoderForFormat returns the built-in Decoder for the given Format, falling back to JSONDecoder.
+func decoderForFormat(format Format) Decoder {
+	switch format {
+	case FormatYAML:
+		return YAMLDecoder{}
+	case FormatINI:
+		return INIDecoder{}
+	default:
+		return JSONDecoder{}
+	}
+}
+
+// formatFromFilename detects a Format from a file's extension, falling back to FormatJSON.
+func formatFromFilename(name string) Format {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".ini":
+		return FormatINI
+	default:
+		return FormatJSON
+	}
+}
+
 // NewComposer creates a new Composer instance.
 // It will use the default "appsettings.json" file reader.
 func NewComposer() (*Composer, error) {
@@ -51,22 +142,68 @@ func NewComposer() (*Composer, error) {
 // NewComposerWithReader creates a new Composer instance.
 // If the provided jsonReadCloser is nil, it will use the default "appsettings.json" file reader.
 // If the default JSON file cannot be found or opened, it returns an error.
+// The settings format is auto-detected from the file extension when falling back to the default file,
+// and defaults to JSON otherwise; use NewComposerWithFormat or WithFormat to be explicit.
 func NewComposerWithReader(jsonReadCloser io.ReadCloser) (*Composer, error) {
+	format := FormatJSON
+	filePath := ""
 	if jsonReadCloser == nil {
 		if f, err := os.Open(DefaultAppSettingsFile); err != nil {
 			return nil, errors.Join(ErrOpeningFile, err)
 		} else {
 			jsonReadCloser = f
+			format = formatFromFilename(DefaultAppSettingsFile)
+			filePath = DefaultAppSettingsFile
 		}
 	}
 	as := &Composer{
 		jsonReader: jsonReadCloser,
+		decoder:    decoderForFormat(format),
 		updater:    nil,
 		validator:  nil,
+		filePath:   filePath,
+	}
+	return as, nil
+}
+
+// NewComposerWithFile creates a new Composer instance reading from the file at path,
+// auto-detecting its Format from the extension. Unlike NewComposerWithReader with a
+// non-nil reader, the resulting Composer remembers path, which is required to use Watch.
+func NewComposerWithFile(path string) (*Composer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(ErrOpeningFile, err)
+	}
+	as := &Composer{
+		jsonReader: f,
+		decoder:    decoderForFormat(formatFromFilename(path)),
+		filePath:   path,
 	}
 	return as, nil
 }
 
+// NewComposerWithFormat creates a new Composer instance using the given Format, regardless of file extension.
+// If the provided jsonReadCloser is nil, it will use the default "appsettings.json" file reader.
+func NewComposerWithFormat(jsonReadCloser io.ReadCloser, format Format) (*Composer, error) {
+	as, err := NewComposerWithReader(jsonReadCloser)
+	if err != nil {
+		return nil, err
+	}
+	return as.WithFormat(format), nil
+}
+
+// WithFormat sets the settings Format (and corresponding Decoder) for the Composer instance.
+func (as *Composer) WithFormat(format Format) *Composer {
+	as.decoder = decoderForFormat(format)
+	return as
+}
+
+// WithDecoder sets a custom Decoder for the Composer instance.
+func (as *Composer) WithDecoder(decoder Decoder) *Composer {
+	as.decoder = decoder
+	return as
+}
+
 // WithUpdater sets the updater anfor the AppSettings instance.
 func (as *Composer) WithUpdater(updater EnvUpdater) *Composer {
 	as.updater = updater
@@ -79,8 +216,27 @@ func (as *Composer) WithValidator(validator Validator) *Composer {
 	return as
 }
 
+// WithEnvSubstitution enables or disables expansion of ${VAR} and ${VAR:-default} tokens
+// inside JSON string values, using os.LookupEnv, before the settings file is decoded. This
+// is only supported for JSON-decoded content: when enabled with any other Decoder, reading
+// fails with ErrEnvSubstitution. It lets secrets be kept out of the committed
+// settings file, e.g. "credentials": "${GOOGLE_APP_CREDENTIALS}".
+func (as *Composer) WithEnvSubstitution(enabled bool) *Composer {
+	as.envSubstitution = enabled
+	return as
+}
+
+// WithSources sets an ordered list of ConfigSource providers for the Composer instance.
+// When set, Read loads settings by applying each source's Load in order instead of the
+// single jsonReader/updater pipeline, with later sources overriding fields set by earlier
+// ones. See FileSource, EnvSource and FlagSource for the built-in providers.
+func (as *Composer) WithSources(sources ...ConfigSource) *Composer {
+	as.sources = sources
+	return as
+}
+
 // Read reads the settings from multiple sources and validates them if a validator is provided.
-func (as *Composer) Read(settings any) (err error) {
+func (as *Composer) Read(settings any) error {
 	// Step 0: Basic validation
 	if as == nil {
 		return ErrAppSettingsNil
@@ -88,46 +244,99 @@ func (as *Composer) Read(settings any) (err error) {
 	if settings == nil {
 		return ErrSettingsParamNil
 	}
+
+	if as.sources != nil {
+		return as.readFromSources(settings)
+	}
+
 	if as.jsonReader == nil {
 		return ErrReaderNil
 	}
 
 	// Step 1: Read settings from file and close it after reading
-	{
-		defer func() {
-			if closeErr := as.jsonReader.Close(); closeErr != nil {
-				err = errors.Join(ErrClosingFile, closeErr)
-			}
-		}()
-		data, err := io.ReadAll(as.jsonReader)
-
-		if err != nil {
-			return errors.Join(ErrReadingFile, err)
-		}
-
-		if err = json.Unmarshal(data, settings); err != nil {
-			return errors.Join(ErrUnmarshalingFile, err)
-		}
+	if err := as.decodeFile(settings); err != nil {
+		return err
 	}
 
 	// Step 2: Override with environment variables, in case updater is provided
-	if as.updater != nil {
-		if err := as.updater.Update(settings); err != nil {
-			return errors.Join(ErrUpdateSettings, err)
-		}
+	if err := as.applyUpdater(settings); err != nil {
+		return err
+	}
+
+	// Step 3: Validate settings in case a validator is provided
+	return as.applyValidator(settings)
+}
+
+// applyUpdater runs the configured updater, in case one is provided.
+func (as *Composer) applyUpdater(settings any) error {
+	if as.updater == nil {
+		return nil
+	}
+	if err := as.updater.Update(settings); err != nil {
+		return errors.Join(ErrUpdateSettings, err)
 	}
-	// if err := envconfig.InitWithOptions(settings, envconfig.Options{AllOptional: true}); err != nil {
-	// 	return errors.Join(fmt.Errorf("failed to update settings with env vars"), err)
-	// }
+	return nil
+}
 
-	//Step 3: Validate settings in case a validator is provided
+// applyValidator runs the configured validator, in case one is provided.
+func (as *Composer) applyValidator(settings any) error {
 	if as.validator == nil {
 		return nil
 	}
 	if errs := as.validator.Validate(settings); errs != nil {
 		return errors.Join(ErrValidateSettings, errs)
 	}
+	return nil
+}
+
+// envSubstitutionLoader is implemented by ConfigSource providers, such as FileSource, that
+// can honor the owning Composer's WithEnvSubstitution setting for a single Load call,
+// without holding it as mutable state on the (potentially shared) ConfigSource itself.
+type envSubstitutionLoader interface {
+	loadWithEnvSubstitution(settings any, enabled bool) error
+}
 
-	// All good, return nil
+// readFromSources loads settings by applying each configured ConfigSource in order, later
+// sources overriding fields set by earlier ones, then validates if a validator is provided.
+func (as *Composer) readFromSources(settings any) error {
+	for _, source := range as.sources {
+		load := source.Load
+		if loader, ok := source.(envSubstitutionLoader); ok {
+			load = func(settings any) error {
+				return loader.loadWithEnvSubstitution(settings, as.envSubstitution)
+			}
+		}
+		if err := load(settings); err != nil {
+			return errors.Join(ErrLoadSettings, err)
+		}
+	}
+	return as.applyValidator(settings)
+}
+
+// decodeFile reads the settings file and decodes it into settings, closing the reader afterwards.
+func (as *Composer) decodeFile(settings any) (err error) {
+	defer func() {
+		if closeErr := as.jsonReader.Close(); closeErr != nil {
+			err = errors.Join(ErrClosingFile, closeErr)
+		}
+	}()
+	data, err := io.ReadAll(as.jsonReader)
+
+	if err != nil {
+		return errors.Join(ErrReadingFile, err)
+	}
+
+	decoder := as.decoder
+	if decoder == nil {
+		decoder = JSONDecoder{}
+	}
+
+	if data, err = substituteEnvIfJSON(data, decoder, as.envSubstitution); err != nil {
+		return err
+	}
+
+	if err = decoder.Decode(data, settings); err != nil {
+		return errors.Join(ErrUnmarshalingFile, err)
+	}
 	return nil
 }
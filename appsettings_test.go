@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/prutswonder/go-appsettings" // Adjust the import path as necessary
@@ -80,24 +81,24 @@ func (u *TestUpdater) Update(settings any) error {
 	return u.UpdateError
 }
 
-func TestAppSettings(t *testing.T) {
+func TestComposer(t *testing.T) {
 	settings := &TestSettings{}
 
-	// A nil AppSettings instance is not allowed.
-	sut := (*appsettings.AppSettings)(nil)
+	// A nil Composer instance is not allowed.
+	sut := (*appsettings.Composer)(nil)
 	err := sut.Read(settings)
 	assert.NotNil(t, err)
 	assert.ErrorContains(t, err, appsettings.ErrAppSettingsNil.Error())
 
 	// A nil reader is not allowed.
-	sut = &appsettings.AppSettings{}
+	sut = &appsettings.Composer{}
 	err = sut.Read(settings)
 	assert.NotNil(t, err)
 	assert.ErrorContains(t, err, appsettings.ErrReaderNil.Error())
 
 	// Faulty reader is accepted at instantiation.
 	reader := TestReader{HasReadError: true}
-	sut, err = appsettings.NewAppSettings(&reader)
+	sut, err = appsettings.NewComposerWithReader(&reader)
 	assert.NoError(t, err)
 
 	// Reading settings with a nil parameter should fail.
@@ -118,7 +119,7 @@ func TestAppSettings(t *testing.T) {
 	assert.ErrorContains(t, err, errors.New("close error").Error())
 
 	// By default this repository does not have an appsettings.json file, so this should fail.
-	_, err = appsettings.NewAppSettings(nil)
+	_, err = appsettings.NewComposerWithReader(nil)
 	assert.NotNil(t, err)
 	assert.ErrorContains(t, err, appsettings.ErrOpeningFile.Error())
 	assert.ErrorContains(t, err, "open appsettings.json")
@@ -137,8 +138,8 @@ func TestAppSettings(t *testing.T) {
 	err = os.WriteFile("appsettings.json", []byte(notJsonContent), 0644)
 	assert.NoError(t, err)
 
-	// Instantiating AppSettings should succeed, even with a faulty appsettings.json.
-	sut, err = appsettings.NewAppSettings(nil)
+	// Instantiating Composer should succeed, even with a faulty appsettings.json.
+	sut, err = appsettings.NewComposerWithReader(nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, sut)
 
@@ -169,14 +170,14 @@ func TestAppSettings(t *testing.T) {
 	}()
 
 	updater := TestUpdater{}
-	sut, err = appsettings.NewAppSettings(nil)
+	sut, err = appsettings.NewComposerWithReader(nil)
 	sut = sut.WithUpdater(&updater)
 
 	// Now that appsettings.json exists, this should succeed without validation.
 	err = sut.Read(settings)
 	assert.NoError(t, err)
 
-	sut, err = appsettings.NewAppSettings(nil)
+	sut, err = appsettings.NewComposerWithReader(nil)
 	sut = sut.WithUpdater(&updater)
 	sut = sut.WithValidator(settings)
 
@@ -189,7 +190,7 @@ func TestAppSettings(t *testing.T) {
 	assert.NotContains(t, err.Error(), "Global.Log.Level")
 
 	updater.GoogleCredentials = "something"
-	sut, err = appsettings.NewAppSettings(nil)
+	sut, err = appsettings.NewComposerWithReader(nil)
 	sut = sut.WithUpdater(&updater)
 
 	// Now that Google.App.Credentials exists, this should succeed.
@@ -201,7 +202,7 @@ func TestAppSettings(t *testing.T) {
 	assert.Equal(t, "something", settings.Google.App.Credentials)
 
 	updater.UpdateError = errors.New("updater error")
-	sut, err = appsettings.NewAppSettings(nil)
+	sut, err = appsettings.NewComposerWithReader(nil)
 	sut = sut.WithUpdater(&updater)
 	sut = sut.WithValidator(settings)
 
@@ -210,3 +211,38 @@ func TestAppSettings(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.ErrorContains(t, err, "updater error")
 }
+
+func TestComposerWithFormat(t *testing.T) {
+	yamlContent := `
+global:
+  log:
+    level: Debug
+cors:
+  origins:
+    - "*"
+`
+	settings := &TestSettings{}
+	reader := io.NopCloser(strings.NewReader(yamlContent))
+	sut, err := appsettings.NewComposerWithFormat(reader, appsettings.FormatYAML)
+	assert.NoError(t, err)
+
+	err = sut.Read(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, "Debug", settings.Global.Log.Level)
+	assert.Equal(t, []string{"*"}, settings.Cors.Origins)
+}
+
+func TestComposerWithFormat_Explicit(t *testing.T) {
+	// INI sections only map one level deep, so this only asserts the non-nested Custom.Enabled field.
+	iniContent := "[Custom]\nEnabled = true\n\n[Custom.Service]\nName = billing\n"
+
+	settings := &TestSettings{}
+	reader := io.NopCloser(strings.NewReader(iniContent))
+	sut, err := appsettings.NewComposerWithReader(reader)
+	assert.NoError(t, err)
+	sut = sut.WithFormat(appsettings.FormatINI)
+
+	err = sut.Read(settings)
+	assert.NoError(t, err)
+	assert.True(t, settings.Custom.Enabled)
+}
@@ -0,0 +1,105 @@
+package appsettings
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+var envTokenPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// substituteEnvIfJSON runs substituteEnvJSON over data when enabled, requiring decoder be
+// JSONDecoder, the only decoder substitution supports. It is a no-op when enabled is false.
+func substituteEnvIfJSON(data []byte, decoder Decoder, enabled bool) ([]byte, error) {
+	if !enabled {
+		return data, nil
+	}
+	if _, isJSON := decoder.(JSONDecoder); !isJSON {
+		return nil, errors.Join(ErrEnvSubstitution, fmt.Errorf("env substitution is only supported for %s, got decoder %T", FormatJSON, decoder))
+	}
+	return substituteEnvJSON(data)
+}
+
+// substituteEnvJSON decodes data as generic JSON, expands ${VAR} and ${VAR:-default}
+// tokens found inside string values (not object keys or numeric literals) using
+// os.LookupEnv, and re-encodes the result. Numbers are decoded as json.Number so large
+// integers round-trip without losing precision to float64.
+func substituteEnvJSON(data []byte) ([]byte, error) {
+	var generic any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&generic); err != nil {
+		return nil, errors.Join(ErrUnmarshalingFile, err)
+	}
+
+	substituted, err := substituteEnvValue(generic)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := json.Marshal(substituted)
+	if err != nil {
+		return nil, errors.Join(ErrEnvSubstitution, err)
+	}
+	return out, nil
+}
+
+// substituteEnvValue recursively expands env tokens in string values of a generic,
+// json.Unmarshal-decoded value tree. Object keys and non-string values are left untouched.
+func substituteEnvValue(v any) (any, error) {
+	switch val := v.(type) {
+	case string:
+		return expandEnvTokens(val)
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			substituted, err := substituteEnvValue(item)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = substituted
+		}
+		return out, nil
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for key, item := range val {
+			substituted, err := substituteEnvValue(item)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = substituted
+		}
+		return out, nil
+	default:
+		return v, nil
+	}
+}
+
+// expandEnvTokens expands every ${VAR} and ${VAR:-default} token in s. It returns
+// ErrEnvSubstitution if a token has no default and its variable is not set.
+func expandEnvTokens(s string) (string, error) {
+	var firstErr error
+	result := envTokenPattern.ReplaceAllStringFunc(s, func(token string) string {
+		if firstErr != nil {
+			return token
+		}
+		match := envTokenPattern.FindStringSubmatch(token)
+		name, hasDefault, def := match[1], match[2] != "", match[3]
+
+		if value, ok := os.LookupEnv(name); ok {
+			return value
+		}
+		if hasDefault {
+			return def
+		}
+		firstErr = errors.Join(ErrEnvSubstitution, fmt.Errorf("environment variable %q is not set", name))
+		return token
+	})
+	if firstErr != nil {
+		return "", firstErr
+	}
+	return result, nil
+}
@@ -0,0 +1,94 @@
+package appsettings_test
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/prutswonder/go-appsettings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposerWithEnvSubstitution(t *testing.T) {
+	os.Setenv("TEST_GOOGLE_APP_CREDENTIALS", "super-secret")
+	defer os.Unsetenv("TEST_GOOGLE_APP_CREDENTIALS")
+
+	jsonContent := `{
+			"global": {"log": {"msg-level": "${TEST_LOG_LEVEL:-Info}"}},
+			"google": {"app": {"credentials": "${TEST_GOOGLE_APP_CREDENTIALS}"}}
+		}`
+
+	settings := &TestSettings{}
+	reader := io.NopCloser(strings.NewReader(jsonContent))
+	sut, err := appsettings.NewComposerWithReader(reader)
+	assert.NoError(t, err)
+	sut = sut.WithEnvSubstitution(true)
+
+	err = sut.Read(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, "Info", settings.Global.Log.Level)
+	assert.Equal(t, "super-secret", settings.Google.App.Credentials)
+}
+
+func TestComposerWithEnvSubstitution_MissingRequiredVar(t *testing.T) {
+	jsonContent := `{"google": {"app": {"credentials": "${TEST_DOES_NOT_EXIST}"}}}`
+
+	settings := &TestSettings{}
+	reader := io.NopCloser(strings.NewReader(jsonContent))
+	sut, err := appsettings.NewComposerWithReader(reader)
+	assert.NoError(t, err)
+	sut = sut.WithEnvSubstitution(true)
+
+	err = sut.Read(settings)
+	assert.NotNil(t, err)
+	assert.ErrorContains(t, err, appsettings.ErrEnvSubstitution.Error())
+	assert.ErrorContains(t, err, "TEST_DOES_NOT_EXIST")
+}
+
+func TestComposerWithEnvSubstitution_Disabled(t *testing.T) {
+	jsonContent := `{"global": {"log": {"msg-level": "${TEST_LOG_LEVEL:-Info}"}}}`
+
+	settings := &TestSettings{}
+	reader := io.NopCloser(strings.NewReader(jsonContent))
+	sut, err := appsettings.NewComposerWithReader(reader)
+	assert.NoError(t, err)
+
+	// Without WithEnvSubstitution, the raw token is left in the string as-is.
+	err = sut.Read(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, "${TEST_LOG_LEVEL:-Info}", settings.Global.Log.Level)
+}
+
+func TestComposerWithEnvSubstitution_RejectsNonJSONFormat(t *testing.T) {
+	yamlContent := "global:\n  log:\n    level: ${TEST_LOG_LEVEL:-Info}\n"
+
+	settings := &TestSettings{}
+	reader := io.NopCloser(strings.NewReader(yamlContent))
+	sut, err := appsettings.NewComposerWithFormat(reader, appsettings.FormatYAML)
+	assert.NoError(t, err)
+	sut = sut.WithEnvSubstitution(true)
+
+	err = sut.Read(settings)
+	assert.NotNil(t, err)
+	assert.ErrorContains(t, err, appsettings.ErrEnvSubstitution.Error())
+}
+
+func TestComposerWithEnvSubstitution_PreservesLargeIntegers(t *testing.T) {
+	type settingsWithID struct {
+		ID   int64
+		Name string
+	}
+	jsonContent := `{"id": 9007199254740993, "name": "${TEST_NAME:-default}"}`
+
+	settings := &settingsWithID{}
+	reader := io.NopCloser(strings.NewReader(jsonContent))
+	sut, err := appsettings.NewComposerWithReader(reader)
+	assert.NoError(t, err)
+	sut = sut.WithEnvSubstitution(true)
+
+	err = sut.Read(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), settings.ID)
+	assert.Equal(t, "default", settings.Name)
+}
@@ -0,0 +1,74 @@
+package appsettings
+
+import (
+	"os"
+	"reflect"
+
+	"github.com/vrischmann/envconfig"
+)
+
+// envUpdater is the built-in EnvUpdater implementation. It overrides settings fields from
+// environment variables, using the same field-chain naming and `envconfig:"..."` tag
+// conventions as github.com/vrischmann/envconfig, plus an `env:"NAME"` tag for fields that
+// need an exact, unprefixed variable name instead of the auto-derived one.
+type envUpdater struct {
+	prefix string
+}
+
+// NewEnvUpdater creates an EnvUpdater that overrides settings fields from environment
+// variables prefixed with prefix, e.g. with prefix "MYAPP" the field Global.Log.Level
+// is read from MYAPP_GLOBAL_LOG_LEVEL. A field tagged `env:"NAME"` is instead read from
+// the exact variable NAME, taking precedence over the auto-derived name. Fields not
+// present in the environment are left untouched.
+func NewEnvUpdater(prefix string) EnvUpdater {
+	return &envUpdater{prefix: prefix}
+}
+
+// Update overrides settings fields from environment variables.
+func (u *envUpdater) Update(settings any) error {
+	if err := envconfig.InitWithOptions(settings, envconfig.Options{
+		Prefix:      u.prefix,
+		AllOptional: true,
+	}); err != nil {
+		return err
+	}
+	return applyEnvTags(reflect.ValueOf(settings))
+}
+
+// applyEnvTags recursively overrides fields tagged `env:"NAME"` from the exact-named
+// environment variable, taking precedence over the auto-derived name envconfig already
+// applied in Update.
+func applyEnvTags(v reflect.Value) error {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldValue := v.Field(i)
+		if underlying := reflect.Indirect(fieldValue); underlying.Kind() == reflect.Struct {
+			if err := applyEnvTags(fieldValue); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name, ok := field.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := setLeafValue(fieldValue, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
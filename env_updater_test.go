@@ -0,0 +1,72 @@
+package appsettings_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/prutswonder/go-appsettings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvUpdater(t *testing.T) {
+	os.Setenv("MYAPP_GLOBAL_LOG_LEVEL", "Warn")
+	os.Setenv("MYAPP_GOOGLE_APP_CREDENTIALS", "from-env")
+	defer func() {
+		os.Unsetenv("MYAPP_GLOBAL_LOG_LEVEL")
+		os.Unsetenv("MYAPP_GOOGLE_APP_CREDENTIALS")
+	}()
+
+	settings := &TestSettings{}
+	settings.Cors.Origins = []string{"*"}
+
+	updater := appsettings.NewEnvUpdater("MYAPP")
+	err := updater.Update(settings)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Warn", settings.Global.Log.Level)
+	assert.Equal(t, "from-env", settings.Google.App.Credentials)
+	assert.Equal(t, []string{"*"}, settings.Cors.Origins)
+}
+
+func TestEnvUpdater_NoMatchingVars(t *testing.T) {
+	settings := &TestSettings{}
+	settings.Global.Log.Level = "Debug"
+
+	updater := appsettings.NewEnvUpdater("UNUSEDPREFIX")
+	err := updater.Update(settings)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Debug", settings.Global.Log.Level)
+}
+
+type EnvTaggedSettings struct {
+	Host string `env:"HOST"`
+}
+
+func TestEnvUpdater_EnvTag(t *testing.T) {
+	os.Setenv("HOST", "tagged.example.com")
+	defer os.Unsetenv("HOST")
+
+	settings := &EnvTaggedSettings{}
+	updater := appsettings.NewEnvUpdater("MYAPP")
+	err := updater.Update(settings)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "tagged.example.com", settings.Host)
+}
+
+func TestEnvUpdater_EnvTagTakesPrecedenceOverAutoDerivedName(t *testing.T) {
+	os.Setenv("MYAPP_HOST", "auto-derived.example.com")
+	os.Setenv("HOST", "tagged.example.com")
+	defer func() {
+		os.Unsetenv("MYAPP_HOST")
+		os.Unsetenv("HOST")
+	}()
+
+	settings := &EnvTaggedSettings{}
+	updater := appsettings.NewEnvUpdater("MYAPP")
+	err := updater.Update(settings)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "tagged.example.com", settings.Host)
+}
@@ -0,0 +1,100 @@
+package appsettings
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// flagSource loads settings from command-line flags derived from each leaf field's
+// dotted path.
+type flagSource struct {
+	args []string
+}
+
+// FlagSource builds a ConfigSource that overrides settings fields from CLI flags derived
+// from each leaf field's dotted path, lower-cased, e.g. the field Global.Log.Level becomes
+// -global.log.level. args is expected to include the program name, as in os.Args; flags
+// not present in args are left untouched.
+func FlagSource(args []string) ConfigSource {
+	return &flagSource{args: args}
+}
+
+func (s *flagSource) Load(settings any) error {
+	leaves := map[string]reflect.Value{}
+	walkLeaves(reflect.ValueOf(settings), "", func(path string, leaf reflect.Value) {
+		leaves[strings.ToLower(path)] = leaf
+	})
+
+	fs := flag.NewFlagSet("appsettings", flag.ContinueOnError)
+	fs.Usage = func() {}
+	for name := range leaves {
+		fs.String(name, "", "")
+	}
+
+	args := s.args
+	if len(args) > 0 {
+		args = args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var setErr error
+	fs.Visit(func(f *flag.Flag) {
+		if setErr != nil {
+			return
+		}
+		setErr = setLeafValue(leaves[f.Name], f.Value.String())
+	})
+	return setErr
+}
+
+// setLeafValue parses raw and assigns it to the settable leaf field v, converting it to
+// v's underlying type.
+func setLeafValue(v reflect.Value, raw string) error {
+	if !v.IsValid() || !v.CanSet() {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Type() == reflect.TypeOf(time.Duration(0)) {
+			d, err := time.ParseDuration(raw)
+			if err != nil {
+				return err
+			}
+			v.SetInt(int64(d))
+			return nil
+		}
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported flag slice element type %s", v.Type().Elem())
+		}
+		v.Set(reflect.ValueOf(strings.Split(raw, ",")))
+	default:
+		return fmt.Errorf("unsupported flag value type %s", v.Type())
+	}
+	return nil
+}
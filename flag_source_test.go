@@ -0,0 +1,37 @@
+package appsettings_test
+
+import (
+	"testing"
+
+	"github.com/prutswonder/go-appsettings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlagSource(t *testing.T) {
+	args := []string{
+		"myapp",
+		"-global.log.level=Warn",
+		"-cors.origins=a,b,c",
+		"-custom.enabled=true",
+	}
+
+	settings := &TestSettings{}
+	source := appsettings.FlagSource(args)
+
+	err := source.Load(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, "Warn", settings.Global.Log.Level)
+	assert.Equal(t, []string{"a", "b", "c"}, settings.Cors.Origins)
+	assert.True(t, settings.Custom.Enabled)
+}
+
+func TestFlagSource_NoFlagsSet(t *testing.T) {
+	settings := &TestSettings{}
+	settings.Global.Log.Level = "Debug"
+
+	source := appsettings.FlagSource([]string{"myapp"})
+	err := source.Load(settings)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Debug", settings.Global.Log.Level)
+}
@@ -0,0 +1,77 @@
+package appsettings
+
+import (
+	"errors"
+	"io"
+	"os"
+)
+
+// ConfigSource is a single layer in a Composer's layered config pipeline, set via
+// WithSources. Each source loads (and, for later sources, overrides) settings fields in
+// the order the sources were given.
+type ConfigSource interface {
+	Load(settings any) error
+}
+
+// fileSource loads settings from a file, auto-detecting its Format from the extension.
+// It holds no mutable state, so a single fileSource is safe to share across Composers.
+type fileSource struct {
+	path string
+}
+
+// FileSource builds a ConfigSource that decodes the file at path into settings, using the
+// same extension-based Format detection as NewComposerWithReader. A missing file is not
+// an error: it is treated as an empty layer, so FileSource is safe to use for optional
+// override files (e.g. "appsettings.override.json"). The owning Composer's
+// WithEnvSubstitution setting is honored, subject to the same JSON-only restriction as the
+// single-reader pipeline.
+func FileSource(path string) ConfigSource {
+	return &fileSource{path: path}
+}
+
+func (s *fileSource) Load(settings any) error {
+	return s.loadWithEnvSubstitution(settings, false)
+}
+
+// loadWithEnvSubstitution is called by Composer.readFromSources instead of Load, passing
+// down the owning Composer's WithEnvSubstitution setting for this one read.
+func (s *fileSource) loadWithEnvSubstitution(settings any, enabled bool) error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Join(ErrOpeningFile, err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return errors.Join(ErrReadingFile, err)
+	}
+
+	decoder := decoderForFormat(formatFromFilename(s.path))
+	if data, err = substituteEnvIfJSON(data, decoder, enabled); err != nil {
+		return err
+	}
+
+	if err := decoder.Decode(data, settings); err != nil {
+		return errors.Join(ErrUnmarshalingFile, err)
+	}
+	return nil
+}
+
+// envSource loads settings from environment variables via the built-in EnvUpdater.
+type envSource struct {
+	updater EnvUpdater
+}
+
+// EnvSource builds a ConfigSource that overrides settings fields from environment
+// variables prefixed with prefix, using the same rules as NewEnvUpdater.
+func EnvSource(prefix string) ConfigSource {
+	return &envSource{updater: NewEnvUpdater(prefix)}
+}
+
+func (s *envSource) Load(settings any) error {
+	return s.updater.Update(settings)
+}
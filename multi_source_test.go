@@ -0,0 +1,85 @@
+package appsettings_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/prutswonder/go-appsettings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposerWithSources(t *testing.T) {
+	baseContent := `{
+			"global": {"log": {"msg-level": "Debug"}},
+			"cors": {"origins": ["*"]}
+		}`
+	overrideContent := `{"global": {"log": {"msg-level": "Warn"}}}`
+
+	err := os.WriteFile("appsettings.base.json", []byte(baseContent), 0644)
+	assert.NoError(t, err)
+	err = os.WriteFile("appsettings.override.json", []byte(overrideContent), 0644)
+	assert.NoError(t, err)
+	defer os.Remove("appsettings.base.json")
+	defer os.Remove("appsettings.override.json")
+
+	os.Setenv("MYAPP_GOOGLE_APP_CREDENTIALS", "from-env")
+	defer os.Unsetenv("MYAPP_GOOGLE_APP_CREDENTIALS")
+
+	settings := &TestSettings{}
+	sut := &appsettings.Composer{}
+	sut = sut.WithSources(
+		appsettings.FileSource("appsettings.base.json"),
+		appsettings.FileSource("appsettings.override.json"),
+		appsettings.FileSource("appsettings.missing.json"),
+		appsettings.EnvSource("MYAPP"),
+	)
+
+	err = sut.Read(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, "Warn", settings.Global.Log.Level)
+	assert.Equal(t, []string{"*"}, settings.Cors.Origins)
+	assert.Equal(t, "from-env", settings.Google.App.Credentials)
+}
+
+func TestComposerWithSources_EnvSubstitution(t *testing.T) {
+	os.Setenv("TEST_ZZ_LEVEL", "Warn")
+	defer os.Unsetenv("TEST_ZZ_LEVEL")
+
+	jsonContent := `{"global": {"log": {"msg-level": "${TEST_ZZ_LEVEL}"}}}`
+	err := os.WriteFile("appsettings.zz.json", []byte(jsonContent), 0644)
+	assert.NoError(t, err)
+	defer os.Remove("appsettings.zz.json")
+
+	settings := &TestSettings{}
+	sut := &appsettings.Composer{}
+	sut = sut.WithSources(appsettings.FileSource("appsettings.zz.json")).WithEnvSubstitution(true)
+
+	err = sut.Read(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, "Warn", settings.Global.Log.Level)
+}
+
+func TestComposerWithSources_EnvSubstitution_RejectsNonJSONFormat(t *testing.T) {
+	yamlContent := "global:\n  log:\n    level: ${TEST_ZZ_LEVEL:-Info}\n"
+	err := os.WriteFile("appsettings.zz.yaml", []byte(yamlContent), 0644)
+	assert.NoError(t, err)
+	defer os.Remove("appsettings.zz.yaml")
+
+	settings := &TestSettings{}
+	sut := &appsettings.Composer{}
+	sut = sut.WithSources(appsettings.FileSource("appsettings.zz.yaml")).WithEnvSubstitution(true)
+
+	err = sut.Read(settings)
+	assert.NotNil(t, err)
+	assert.ErrorContains(t, err, appsettings.ErrEnvSubstitution.Error())
+}
+
+func TestComposerWithSources_Validates(t *testing.T) {
+	settings := &TestSettings{}
+	sut := &appsettings.Composer{}
+	sut = sut.WithSources(appsettings.EnvSource("MYAPP")).WithValidator(settings)
+
+	err := sut.Read(settings)
+	assert.NotNil(t, err)
+	assert.ErrorContains(t, err, appsettings.ErrValidateSettings.Error())
+}
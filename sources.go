@@ -0,0 +1,163 @@
+package appsettings
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+type (
+	// Source identifies where a settings field's final value came from.
+	Source string
+
+	// SourceMap maps a leaf field's dotted path (e.g. "Global.Log.Level") to the Source
+	// its final value came from.
+	SourceMap map[string]Source
+)
+
+const (
+	// SourceDefault means the field was left at its zero value.
+	SourceDefault Source = "default"
+	// SourceFile means the field's final value came from the settings file.
+	SourceFile Source = "file"
+	// SourceEnv means the field's final value was set or overridden by the updater.
+	SourceEnv Source = "env"
+	// SourceValidator means the field's final value was set or overridden by the validator.
+	SourceValidator Source = "validator"
+)
+
+// ReadWithSources reads the settings the same way Read does, and additionally returns a
+// SourceMap recording, for each leaf field, which step last set its final value. It does not
+// support a Composer configured via WithSources, since a SourceMap's fixed file/env/validator
+// steps don't generalize to an arbitrary list of ConfigSource providers; use Read instead.
+func (as *Composer) ReadWithSources(settings any) (SourceMap, error) {
+	// Step 0: Basic validation
+	if as == nil {
+		return nil, ErrAppSettingsNil
+	}
+	if settings == nil {
+		return nil, ErrSettingsParamNil
+	}
+	if reflect.ValueOf(settings).Kind() != reflect.Ptr {
+		return nil, ErrSettingsNotPointer
+	}
+	if as.sources != nil {
+		return nil, ErrSourceMapUnsupported
+	}
+	if as.jsonReader == nil {
+		return nil, ErrReaderNil
+	}
+
+	zero := reflect.New(reflect.TypeOf(settings).Elem()).Interface()
+
+	// Step 1: Read settings from file
+	if err := as.decodeFile(settings); err != nil {
+		return nil, err
+	}
+	afterFile, err := cloneSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Override with environment variables, in case updater is provided
+	if as.updater != nil {
+		if err := as.updater.Update(settings); err != nil {
+			return nil, errors.Join(ErrUpdateSettings, err)
+		}
+	}
+	afterEnv, err := cloneSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Validate settings in case a validator is provided
+	if as.validator != nil {
+		if errs := as.validator.Validate(settings); errs != nil {
+			return nil, errors.Join(ErrValidateSettings, errs)
+		}
+	}
+
+	return buildSourceMap(zero, afterFile, afterEnv, settings), nil
+}
+
+// Serialize marshals the SourceMap to indented JSON, e.g. for exposing on a
+// /config/environment style admin endpoint.
+func (sm SourceMap) Serialize() ([]byte, error) {
+	return json.MarshalIndent(sm, "", "  ")
+}
+
+// cloneSettings deep-copies a *T settings value via a JSON round-trip.
+func cloneSettings(settings any) (any, error) {
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	clone := reflect.New(reflect.TypeOf(settings).Elem()).Interface()
+	if err := json.Unmarshal(data, clone); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
+
+// buildSourceMap diffs the zero, post-file, post-env and final (post-validate) snapshots
+// of a settings struct to determine which step last set each leaf field.
+func buildSourceMap(zero, afterFile, afterEnv, final any) SourceMap {
+	zeroLeaves := map[string]any{}
+	fileLeaves := map[string]any{}
+	envLeaves := map[string]any{}
+	finalLeaves := map[string]any{}
+	collectLeaves(reflect.ValueOf(zero), "", zeroLeaves)
+	collectLeaves(reflect.ValueOf(afterFile), "", fileLeaves)
+	collectLeaves(reflect.ValueOf(afterEnv), "", envLeaves)
+	collectLeaves(reflect.ValueOf(final), "", finalLeaves)
+
+	sm := SourceMap{}
+	for path, finalVal := range finalLeaves {
+		switch {
+		case !reflect.DeepEqual(finalVal, envLeaves[path]):
+			sm[path] = SourceValidator
+		case !reflect.DeepEqual(envLeaves[path], fileLeaves[path]):
+			sm[path] = SourceEnv
+		case !reflect.DeepEqual(fileLeaves[path], zeroLeaves[path]):
+			sm[path] = SourceFile
+		default:
+			sm[path] = SourceDefault
+		}
+	}
+	return sm
+}
+
+// collectLeaves recursively walks the exported fields of a struct, recording each leaf
+// field's value under its dotted path (e.g. "Global.Log.Level").
+func collectLeaves(v reflect.Value, prefix string, out map[string]any) {
+	walkLeaves(v, prefix, func(path string, leaf reflect.Value) {
+		out[path] = leaf.Interface()
+	})
+}
+
+// walkLeaves recursively walks the exported fields of a struct, invoking visit with each
+// leaf field's dotted path (e.g. "Global.Log.Level") and settable reflect.Value.
+func walkLeaves(v reflect.Value, prefix string, visit func(path string, leaf reflect.Value)) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		path := field.Name
+		if prefix != "" {
+			path = prefix + "." + field.Name
+		}
+
+		fieldValue := v.Field(i)
+		if underlying := reflect.Indirect(fieldValue); underlying.Kind() == reflect.Struct {
+			walkLeaves(fieldValue, path, visit)
+			continue
+		}
+		visit(path, fieldValue)
+	}
+}
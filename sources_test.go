@@ -0,0 +1,61 @@
+package appsettings_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/prutswonder/go-appsettings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadWithSources(t *testing.T) {
+	jsonContent := `{
+			"global": {
+				"log": {
+					"msg-level": "Debug"
+				}
+			},
+			"cors": {
+				"origins": ["*"]
+			}
+		}`
+	err := os.WriteFile("appsettings.json", []byte(jsonContent), 0644)
+	assert.NoError(t, err)
+	defer func() {
+		if err = os.Remove("appsettings.json"); err != nil {
+			t.Errorf("Failed to remove appsettings.json: %v", err)
+		}
+	}()
+
+	settings := &TestSettings{}
+	updater := &TestUpdater{GoogleCredentials: "from-env"}
+	sut, err := appsettings.NewComposerWithReader(nil)
+	assert.NoError(t, err)
+	sut = sut.WithUpdater(updater)
+
+	sources, err := sut.ReadWithSources(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, appsettings.SourceFile, sources["Global.Log.Level"])
+	assert.Equal(t, appsettings.SourceEnv, sources["Google.App.Credentials"])
+	assert.Equal(t, appsettings.SourceDefault, sources["Custom.Enabled"])
+
+	data, err := sources.Serialize()
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "Global.Log.Level")
+}
+
+func TestReadWithSources_NonPointerSettings(t *testing.T) {
+	sut := &appsettings.Composer{}
+
+	_, err := sut.ReadWithSources(TestSettings{})
+	assert.ErrorIs(t, err, appsettings.ErrSettingsNotPointer)
+}
+
+func TestReadWithSources_SourcesModeUnsupported(t *testing.T) {
+	settings := &TestSettings{}
+	sut := &appsettings.Composer{}
+	sut = sut.WithSources(appsettings.EnvSource("MYAPP"))
+
+	_, err := sut.ReadWithSources(settings)
+	assert.ErrorIs(t, err, appsettings.ErrSourceMapUnsupported)
+}
@@ -0,0 +1,19 @@
+package appsettings
+
+import validatortag "gopkg.in/validator.v2"
+
+// tagValidator is a Validator implementation backed by gopkg.in/validator.v2.
+type tagValidator struct{}
+
+// NewTagValidator creates a Validator that validates settings fields annotated with
+// `validate:"..."` struct tags (e.g. `validate:"nonzero"`, `validate:"min=1,max=65535"`),
+// instead of requiring a per-project Validate method. All fields are checked, and any
+// failures are aggregated into a single error rather than stopping at the first one.
+func NewTagValidator() Validator {
+	return tagValidator{}
+}
+
+// Validate validates settings against its `validate:"..."` struct tags.
+func (tagValidator) Validate(settings any) error {
+	return validatortag.Validate(settings)
+}
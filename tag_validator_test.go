@@ -0,0 +1,42 @@
+package appsettings_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/prutswonder/go-appsettings"
+	"github.com/stretchr/testify/assert"
+)
+
+type TagValidatedSettings struct {
+	Name string `validate:"nonzero"`
+	Port int    `validate:"min=1,max=65535"`
+}
+
+func TestTagValidator(t *testing.T) {
+	validator := appsettings.NewTagValidator()
+
+	// Both fields are invalid; errors should be aggregated rather than short-circuiting.
+	err := validator.Validate(&TagValidatedSettings{Name: "", Port: 0})
+	assert.NotNil(t, err)
+	assert.ErrorContains(t, err, "Name")
+	assert.ErrorContains(t, err, "Port")
+
+	err = validator.Validate(&TagValidatedSettings{Name: "svc", Port: 8080})
+	assert.NoError(t, err)
+}
+
+func TestComposerWithTagValidator(t *testing.T) {
+	jsonContent := `{"name": "", "port": 0}`
+	settings := &TagValidatedSettings{}
+
+	reader := io.NopCloser(strings.NewReader(jsonContent))
+	sut, err := appsettings.NewComposerWithReader(reader)
+	assert.NoError(t, err)
+	sut = sut.WithValidator(appsettings.NewTagValidator())
+
+	err = sut.Read(settings)
+	assert.NotNil(t, err)
+	assert.ErrorContains(t, err, appsettings.ErrValidateSettings.Error())
+}
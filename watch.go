@@ -0,0 +1,138 @@
+package appsettings
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchDebounce is how long Watch waits after the last matching fsnotify event before
+// reloading, so it doesn't read a file while a writer is still truncating/writing it.
+const watchDebounce = 100 * time.Millisecond
+
+// Watch watches the Composer's underlying config file (set via NewComposerWithFile, or
+// the default file used by NewComposerWithReader(nil)) for writes and re-runs the full
+// decode -> update -> validate pipeline into settings whenever it changes, invoking
+// onChange with the result (nil on success). It blocks until ctx is cancelled, at which
+// point it stops watching and returns nil.
+func (as *Composer) Watch(ctx context.Context, settings any, onChange func(err error)) error {
+	if as == nil {
+		return ErrAppSettingsNil
+	}
+	if settings == nil {
+		return ErrSettingsParamNil
+	}
+	if as.filePath == "" {
+		return ErrWatchUnsupported
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Join(ErrWatcherSetup, err)
+	}
+	defer watcher.Close()
+
+	// Watch the containing directory rather than the file itself, since editors and
+	// config-management tools commonly replace the file via rename rather than writing
+	// to it in place.
+	if err := watcher.Add(filepath.Dir(as.filePath)); err != nil {
+		return errors.Join(ErrWatcherSetup, err)
+	}
+
+	timer := time.NewTimer(0)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(as.filePath) {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			// Debounce: a single save often fires several events (e.g. truncate then
+			// write). Wait for a quiet period before reloading so the file is settled.
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(watchDebounce)
+
+		case <-timer.C:
+			if err := as.reload(settings); err != nil {
+				onChange(err)
+			} else {
+				onChange(nil)
+				as.notifyListeners()
+			}
+
+		case watchErr, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			onChange(watchErr)
+		}
+	}
+}
+
+// reload re-opens the underlying config file and re-runs the decode -> update -> validate
+// pipeline into settings.
+func (as *Composer) reload(settings any) error {
+	f, err := os.Open(as.filePath)
+	if err != nil {
+		return errors.Join(ErrOpeningFile, err)
+	}
+	as.jsonReader = f
+
+	if err := as.decodeFile(settings); err != nil {
+		return err
+	}
+	if err := as.applyUpdater(settings); err != nil {
+		return err
+	}
+	return as.applyValidator(settings)
+}
+
+// AddConfigListener registers fn to be called, in addition to Watch's onChange callback,
+// every time Watch successfully reloads the settings. If id is already registered, fn
+// replaces the previous listener.
+func (as *Composer) AddConfigListener(id string, fn func()) {
+	as.listenersMu.Lock()
+	defer as.listenersMu.Unlock()
+	if as.listeners == nil {
+		as.listeners = map[string]func(){}
+	}
+	as.listeners[id] = fn
+}
+
+// RemoveConfigListener unregisters the listener previously registered under id, if any.
+func (as *Composer) RemoveConfigListener(id string) {
+	as.listenersMu.Lock()
+	defer as.listenersMu.Unlock()
+	delete(as.listeners, id)
+}
+
+// notifyListeners calls every registered config listener.
+func (as *Composer) notifyListeners() {
+	as.listenersMu.Lock()
+	defer as.listenersMu.Unlock()
+	for _, fn := range as.listeners {
+		fn()
+	}
+}
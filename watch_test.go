@@ -0,0 +1,124 @@
+package appsettings_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/prutswonder/go-appsettings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposerWatch(t *testing.T) {
+	path := "appsettings.watch.json"
+	err := os.WriteFile(path, []byte(`{"global": {"log": {"msg-level": "Debug"}}}`), 0644)
+	assert.NoError(t, err)
+	defer os.Remove(path)
+
+	settings := &TestSettings{}
+	sut, err := appsettings.NewComposerWithFile(path)
+	assert.NoError(t, err)
+	err = sut.Read(settings)
+	assert.NoError(t, err)
+	assert.Equal(t, "Debug", settings.Global.Log.Level)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changed := make(chan error, 1)
+	listenerCalled := make(chan struct{}, 1)
+	sut.AddConfigListener("test", func() {
+		select {
+		case listenerCalled <- struct{}{}:
+		default:
+		}
+	})
+
+	go func() {
+		_ = sut.Watch(ctx, settings, func(err error) {
+			changed <- err
+		})
+	}()
+
+	// Give the watcher time to start before triggering a change.
+	time.Sleep(100 * time.Millisecond)
+	err = os.WriteFile(path, []byte(`{"global": {"log": {"msg-level": "Warn"}}}`), 0644)
+	assert.NoError(t, err)
+
+	select {
+	case err := <-changed:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Watch to notice the file change")
+	}
+
+	assert.Equal(t, "Warn", settings.Global.Log.Level)
+
+	select {
+	case <-listenerCalled:
+	case <-time.After(1 * time.Second):
+		t.Fatal("config listener was not called")
+	}
+
+	sut.RemoveConfigListener("test")
+}
+
+func TestComposerWatch_ListenerNotCalledOnReloadError(t *testing.T) {
+	path := "appsettings.watch.invalid.json"
+	err := os.WriteFile(path, []byte(`{"global": {"log": {"msg-level": "Debug"}}}`), 0644)
+	assert.NoError(t, err)
+	defer os.Remove(path)
+
+	settings := &TestSettings{}
+	sut, err := appsettings.NewComposerWithFile(path)
+	assert.NoError(t, err)
+	err = sut.Read(settings)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changed := make(chan error, 1)
+	listenerCalled := make(chan struct{}, 1)
+	sut.AddConfigListener("test-invalid", func() {
+		select {
+		case listenerCalled <- struct{}{}:
+		default:
+		}
+	})
+
+	go func() {
+		_ = sut.Watch(ctx, settings, func(err error) {
+			changed <- err
+		})
+	}()
+
+	// Give the watcher time to start before triggering a change.
+	time.Sleep(100 * time.Millisecond)
+	err = os.WriteFile(path, []byte(`{not valid json`), 0644)
+	assert.NoError(t, err)
+
+	select {
+	case err := <-changed:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Watch to notice the file change")
+	}
+
+	select {
+	case <-listenerCalled:
+		t.Fatal("config listener was called despite reload failing")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	sut.RemoveConfigListener("test-invalid")
+}
+
+func TestComposerWatch_Unsupported(t *testing.T) {
+	settings := &TestSettings{}
+	sut := &appsettings.Composer{}
+
+	err := sut.Watch(context.Background(), settings, func(error) {})
+	assert.ErrorIs(t, err, appsettings.ErrWatchUnsupported)
+}